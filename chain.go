@@ -0,0 +1,87 @@
+package flashbot
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// ChainConfig describes one chain a Flashbot client, or code built around it, can target.
+type ChainConfig struct {
+	Name        string
+	RelayURL    string
+	WethAddress common.Address
+	ExplorerURL string
+}
+
+var (
+	chainsMu sync.RWMutex
+	chains   = map[int64]ChainConfig{
+		1: {
+			Name:        "mainnet",
+			RelayURL:    "https://relay.flashbots.net",
+			WethAddress: common.HexToAddress("0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2"),
+			ExplorerURL: "https://etherscan.io",
+		},
+		11155111: {
+			Name:        "sepolia",
+			RelayURL:    "https://relay-sepolia.flashbots.net",
+			WethAddress: common.HexToAddress("0xfff9976782d46cc05630d1f6ebab18b2324d6b14"),
+			ExplorerURL: "https://sepolia.etherscan.io",
+		},
+		17000: {
+			Name:        "holesky",
+			RelayURL:    "https://relay-holesky.flashbots.net",
+			WethAddress: common.HexToAddress("0x94b008aa00579c1307b0ef2c499ad98a8ce58e58"),
+			ExplorerURL: "https://holesky.etherscan.io",
+		},
+		137: {
+			Name:        "polygon",
+			RelayURL:    "https://polygon.api.blxrbdn.com",
+			WethAddress: common.HexToAddress("0x7ceb23fd6bc0add59e62ac25578270cff1b9f619"),
+			ExplorerURL: "https://polygonscan.com",
+		},
+		42161: {
+			Name:        "arbitrum",
+			RelayURL:    "https://arbitrum.api.blxrbdn.com",
+			WethAddress: common.HexToAddress("0x82af49447d8a07e3bd95bd0d56f35241523fbab1"),
+			ExplorerURL: "https://arbiscan.io",
+		},
+		10: {
+			Name:        "optimism",
+			RelayURL:    "https://optimism.api.blxrbdn.com",
+			WethAddress: common.HexToAddress("0x4200000000000000000000000000000000000006"),
+			ExplorerURL: "https://optimistic.etherscan.io",
+		},
+		56: {
+			Name:        "bsc",
+			RelayURL:    "https://bsc.api.blxrbdn.com",
+			WethAddress: common.HexToAddress("0xbb4cdb9cbd36b01bd1cbaebf2de08d9173bc095c"),
+			ExplorerURL: "https://bscscan.com",
+		},
+	}
+)
+
+// GetChainConfig looks up the registered ChainConfig for chainID, including any chain added
+// or overridden via RegisterChain.
+func GetChainConfig(chainID int64) (ChainConfig, error) {
+	chainsMu.RLock()
+	defer chainsMu.RUnlock()
+
+	cfg, ok := chains[chainID]
+	if !ok {
+		return ChainConfig{}, errors.Errorf("chain id not registered id:%v, call RegisterChain first", chainID)
+	}
+
+	return cfg, nil
+}
+
+// RegisterChain adds or overrides the ChainConfig for chainID, so callers can target custom
+// chains, including devnets and L2 testnets, without forking the package.
+func RegisterChain(chainID int64, cfg ChainConfig) {
+	chainsMu.Lock()
+	defer chainsMu.Unlock()
+
+	chains[chainID] = cfg
+}
@@ -0,0 +1,47 @@
+package flashbot
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/cryptoriums/telliot/pkg/private_file"
+	"github.com/joho/godotenv"
+	"golang.org/x/tools/godoc/util"
+)
+
+// LoadEnv reads .env into the process environment, decrypting it first if it isn't plain
+// text. It is explicit rather than an init() side effect so that importing the package as a
+// library doesn't force a .env file to exist.
+func LoadEnv() error {
+	env, err := ioutil.ReadFile(".env")
+	if err != nil {
+		return err
+	}
+	if !util.IsText(env) {
+		env = private_file.DecryptWithPasswordLoop(env)
+	}
+
+	rr := bytes.NewReader(env)
+	envMap, err := godotenv.Parse(rr)
+	if err != nil {
+		return err
+	}
+
+	// Copied from the godotenv source code.
+	currentEnv := map[string]bool{}
+	rawEnv := os.Environ()
+	for _, rawEnvLine := range rawEnv {
+		key := strings.Split(rawEnvLine, "=")[0]
+		currentEnv[key] = true
+	}
+
+	for key, value := range envMap {
+		if !currentEnv[key] {
+			os.Setenv(key, value)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,285 @@
+// Package flashbot is a minimal client for submitting transaction bundles to Flashbots
+// and other MEV relays.
+package flashbot
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+)
+
+// Flashbot signs transactions for chainID and submits bundles to one or more MEV relays.
+type Flashbot struct {
+	chainID int64
+	privKey *ecdsa.PrivateKey
+	relays  []Relay
+	signer  types.Signer
+	client  *http.Client
+
+	sentBundlesMu sync.Mutex
+	sentBundles   map[common.Hash]sentBundle
+}
+
+// Option customizes a Flashbot client beyond the defaults New derives from the chain's
+// registered ChainConfig.
+type Option func(*Flashbot)
+
+// WithRelays overrides the relay(s) a client submits to, replacing the chain's registered
+// default relay. Pass several to fan submissions out to all of them at once.
+func WithRelays(relays ...Relay) Option {
+	return func(f *Flashbot) { f.relays = relays }
+}
+
+// WithHTTPClient overrides the HTTP client used to talk to relays.
+func WithHTTPClient(client *http.Client) Option {
+	return func(f *Flashbot) { f.client = client }
+}
+
+// WithSigner overrides the tx signer, e.g. to pin a specific fork rule set instead of
+// following the chain id.
+func WithSigner(signer types.Signer) Option {
+	return func(f *Flashbot) { f.signer = signer }
+}
+
+// New creates a Flashbot client for chainID, signing transactions with privKey. It defaults
+// to the single relay of chainID's ChainConfig, built in or added via RegisterChain,
+// authenticated with privKey;
+// apply opts, e.g. WithRelays, to override that.
+func New(chainID int64, privKey *ecdsa.PrivateKey, opts ...Option) (*Flashbot, error) {
+	cfg, err := GetChainConfig(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &Flashbot{
+		chainID:     chainID,
+		privKey:     privKey,
+		relays:      []Relay{{URL: cfg.RelayURL, SigningKey: privKey}},
+		signer:      types.LatestSignerForChainID(big.NewInt(chainID)),
+		client:      http.DefaultClient,
+		sentBundles: map[common.Hash]sentBundle{},
+	}
+
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	if len(f.relays) == 0 {
+		return nil, errors.New("no relay configured")
+	}
+
+	return f, nil
+}
+
+// NewSignedTX builds and signs a legacy (type-0) transaction priced with a single gasPrice.
+func (f *Flashbot) NewSignedTX(
+	data []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	value *big.Int,
+	to common.Address,
+	nonce uint64,
+) (string, *types.Transaction, error) {
+	tx := types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      gasLimit,
+		To:       &to,
+		Value:    value,
+		Data:     data,
+	})
+
+	return f.signTx(tx)
+}
+
+// NewSignedDynamicTX builds and signs an EIP-1559 (type-2) transaction, priced with a fee
+// cap (maxFee) and a tip (maxPriorityFee) instead of a single gasPrice. Bundles submitted to
+// post-London chains should prefer this over NewSignedTX, legacy and dynamic-fee txs can be
+// freely mixed in the same bundle since CallBundle/SendBundle only deal in signed tx hex.
+func (f *Flashbot) NewSignedDynamicTX(
+	data []byte,
+	gasLimit uint64,
+	maxFee *big.Int,
+	maxPriorityFee *big.Int,
+	value *big.Int,
+	to common.Address,
+	nonce uint64,
+) (string, *types.Transaction, error) {
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(f.chainID),
+		Nonce:     nonce,
+		GasTipCap: maxPriorityFee,
+		GasFeeCap: maxFee,
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+	})
+
+	return f.signTx(tx)
+}
+
+func (f *Flashbot) signTx(tx *types.Transaction) (string, *types.Transaction, error) {
+	signedTx, err := types.SignTx(tx, f.signer, f.privKey)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "signing tx")
+	}
+
+	rlpData, err := signedTx.MarshalBinary()
+	if err != nil {
+		return "", nil, errors.Wrap(err, "marshaling signed tx")
+	}
+
+	return hexutil.Encode(rlpData), signedTx, nil
+}
+
+// SuggestFeeCap fetches the current base fee from client and scales it into a (maxFee,
+// maxPriorityFee) pair that NewSignedDynamicTX can use as sane defaults. maxFee is twice the
+// current base fee plus the requested priorityFee, which stays above the base fee through
+// five consecutive blocks of maximum (12.5%) base fee increase.
+func SuggestFeeCap(ctx context.Context, client *ethclient.Client, priorityFee *big.Int) (maxFee *big.Int, maxPriorityFee *big.Int, err error) {
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "getting latest header")
+	}
+	if head.BaseFee == nil {
+		return nil, nil, errors.New("chain has no base fee, is it post-London?")
+	}
+
+	maxFee = new(big.Int).Mul(head.BaseFee, big.NewInt(2))
+	maxFee.Add(maxFee, priorityFee)
+
+	return maxFee, priorityFee, nil
+}
+
+// BundleResp is one relay's response to simulating or submitting a bundle. Results is only
+// populated by CallBundle, since eth_sendBundle does not simulate the bundle it accepts.
+type BundleResp struct {
+	BundleHash string          `json:"bundleHash"`
+	Results    json.RawMessage `json:"results"`
+}
+
+// BundleRelayResult is one relay's outcome of a bundle submission fanned out across every
+// relay configured on the client, so callers can tell which relays accepted, rejected, or
+// errored on a bundle without running one client per relay.
+type BundleRelayResult struct {
+	Relay string
+	Resp  *BundleResp
+	Err   error
+}
+
+func decodeBundleResults(raws []RelayResult) []BundleRelayResult {
+	out := make([]BundleRelayResult, len(raws))
+	for i, raw := range raws {
+		out[i] = BundleRelayResult{Relay: raw.Relay, Err: raw.Err}
+		if raw.Err != nil {
+			continue
+		}
+
+		var resp BundleResp
+		if err := json.Unmarshal(raw.Raw, &resp); err != nil {
+			out[i].Err = errors.Wrap(err, "unmarshaling bundle response")
+			continue
+		}
+		out[i].Resp = &resp
+	}
+
+	return out
+}
+
+// CallBundle simulates a bundle of signed txs against the latest block on every configured
+// relay without submitting it, so callers can check for reverts before paying to get it
+// included.
+func (f *Flashbot) CallBundle(txs []string) ([]BundleRelayResult, error) {
+	if len(f.relays) == 0 {
+		return nil, errors.New("no relay configured")
+	}
+
+	return decodeBundleResults(f.doAll("eth_callBundle", []interface{}{
+		map[string]interface{}{
+			"txs":         txs,
+			"blockNumber": "latest",
+		},
+	})), nil
+}
+
+// SendBundle submits a bundle of signed txs for inclusion in blockNumber to every configured
+// relay. Submit the same bundle for a range of target blocks since there is no guarantee
+// which one it lands in.
+func (f *Flashbot) SendBundle(txs []string, blockNumber uint64) ([]BundleRelayResult, error) {
+	if len(f.relays) == 0 {
+		return nil, errors.New("no relay configured")
+	}
+
+	results := decodeBundleResults(f.doAll("eth_sendBundle", []interface{}{
+		map[string]interface{}{
+			"txs":         txs,
+			"blockNumber": hexutil.EncodeUint64(blockNumber),
+		},
+	}))
+
+	for _, result := range results {
+		if result.Err != nil || result.Resp == nil {
+			continue
+		}
+		if err := f.rememberSentBundle(common.HexToHash(result.Resp.BundleHash), txs, blockNumber); err != nil {
+			return results, errors.Wrap(err, "remembering sent bundle")
+		}
+	}
+
+	return results, nil
+}
+
+// PrivateTxResp is one relay's response to submitting a private transaction.
+type PrivateTxResp struct {
+	TxHash string `json:"txHash"`
+}
+
+// PrivateTxRelayResult is one relay's outcome of a private transaction submission.
+type PrivateTxRelayResult struct {
+	Relay string
+	Resp  *PrivateTxResp
+	Err   error
+}
+
+// SendPrivateTransaction submits a single signed tx directly to block builders via
+// eth_sendPrivateTransaction on every configured relay, skipping the public mempool. The tx
+// is dropped if it isn't included by maxBlockNumber.
+func (f *Flashbot) SendPrivateTransaction(txHex string, maxBlockNumber uint64) ([]PrivateTxRelayResult, error) {
+	if len(f.relays) == 0 {
+		return nil, errors.New("no relay configured")
+	}
+
+	raws := f.doAll("eth_sendPrivateTransaction", []interface{}{
+		map[string]interface{}{
+			"tx":             txHex,
+			"maxBlockNumber": hexutil.EncodeUint64(maxBlockNumber),
+		},
+	})
+
+	out := make([]PrivateTxRelayResult, len(raws))
+	for i, raw := range raws {
+		out[i] = PrivateTxRelayResult{Relay: raw.Relay, Err: raw.Err}
+		if raw.Err != nil {
+			continue
+		}
+
+		var resp PrivateTxResp
+		if err := json.Unmarshal(raw.Raw, &resp); err != nil {
+			out[i].Err = errors.Wrap(err, "unmarshaling private tx response")
+			continue
+		}
+		out[i].Resp = &resp
+	}
+
+	return out, nil
+}
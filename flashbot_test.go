@@ -1,18 +1,15 @@
 package flashbot
 
 import (
-	"bytes"
 	"context"
 	"crypto/ecdsa"
 	"fmt"
-	"io/ioutil"
 	"math/big"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/cryptoriums/telliot/pkg/private_file"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -20,20 +17,13 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/log/level"
-	"github.com/joho/godotenv"
 	"github.com/pkg/errors"
-	"golang.org/x/tools/godoc/util"
 )
 
 const (
-	gasLimit    = 3_000_000
-	gasPrice    = 10 * params.GWei
-	blockNumMax = 10
-
-	// Some ERC20 token with approve function.
-	contractAddressGoerli  = "0xf74a5ca65e4552cff0f13b116113ccb493c580c5"
-	contractAddressRinkeby = "0xdf032bc4b9dc2782bb09352007d4c57b75160b15"
-	contractAddressMainnet = "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2"
+	gasLimit       = 3_000_000
+	gasPriorityFee = 2 * params.GWei
+	blockNumMax    = 10
 )
 
 var logger log.Logger
@@ -44,37 +34,14 @@ func init() {
 		"ts", log.TimestampFormat(func() time.Time { return time.Now().UTC() }, "jan 02 15:04:05.00"),
 		"caller", log.Caller(5),
 	)
-
-	env, err := ioutil.ReadFile(".env")
-	ExitOnError(logger, err)
-	if !util.IsText(env) {
-		level.Info(logger).Log("msg", "env file is encrypted")
-		env = private_file.DecryptWithPasswordLoop(env)
-	}
-
-	rr := bytes.NewReader(env)
-	envMap, err := godotenv.Parse(rr)
-	ExitOnError(logger, err)
-
-	// Copied from the godotenv source code.
-	currentEnv := map[string]bool{}
-	rawEnv := os.Environ()
-	for _, rawEnvLine := range rawEnv {
-		key := strings.Split(rawEnvLine, "=")[0]
-		currentEnv[key] = true
-	}
-
-	for key, value := range envMap {
-		if !currentEnv[key] {
-			os.Setenv(key, value)
-		}
-	}
 }
 
 func Example() {
 	ctx, cncl := context.WithTimeout(context.Background(), time.Hour)
 	defer cncl()
 
+	ExitOnError(logger, LoadEnv())
+
 	nodeURL := os.Getenv("NODE_URL")
 
 	client, err := ethclient.DialContext(ctx, nodeURL)
@@ -84,8 +51,9 @@ func Example() {
 	ExitOnError(logger, err)
 	level.Info(logger).Log("msg", "network", "id", netID.String(), "node", nodeURL)
 
-	addr, err := GetContractAddress(netID)
+	chainCfg, err := GetChainConfig(netID.Int64())
 	ExitOnError(logger, err)
+	level.Info(logger).Log("msg", "chain", "name", chainCfg.Name, "relay", chainCfg.RelayURL)
 
 	pubKey, privKey, err := GetKeys()
 	ExitOnError(logger, err)
@@ -107,12 +75,16 @@ func Example() {
 	)
 	ExitOnError(logger, err)
 
-	txHex, tx, err := flashbot.NewSignedTX(
+	maxFee, maxPriorityFee, err := SuggestFeeCap(ctx, client, big.NewInt(gasPriorityFee))
+	ExitOnError(logger, err)
+
+	txHex, tx, err := flashbot.NewSignedDynamicTX(
 		data,
 		gasLimit,
-		big.NewInt(gasPrice),
+		maxFee,
+		maxPriorityFee,
 		big.NewInt(0),
-		addr,
+		chainCfg.WethAddress,
 		nonce,
 	)
 	ExitOnError(logger, err)
@@ -131,12 +103,15 @@ func Example() {
 		"respStruct", fmt.Sprintf("%+v", resp),
 	)
 
+	targetBlocks := make([]uint64, 0, blockNumMax-1)
 	for i := uint64(1); i < blockNumMax; i++ {
+		target := blockNumber + i
 		resp, err = flashbot.SendBundle(
 			[]string{txHex},
-			blockNumber+i,
+			target,
 		)
 		ExitOnError(logger, err)
+		targetBlocks = append(targetBlocks, target)
 	}
 
 	level.Info(logger).Log("msg", "Sent Bundle",
@@ -144,6 +119,34 @@ func Example() {
 		"respStruct", fmt.Sprintf("%+v", resp),
 	)
 
+	bundleHash, err := FirstBundleHash(resp)
+	ExitOnError(logger, err)
+
+	// Diagnose why the bundle was or wasn't included in any of the blocks it targeted.
+	for _, target := range targetBlocks {
+		stats, err := flashbot.GetBundleStats(bundleHash, target)
+		ExitOnError(logger, err)
+
+		level.Info(logger).Log("msg", "Bundle stats",
+			"block", target,
+			"statsStruct", fmt.Sprintf("%+v", stats),
+		)
+	}
+
+	includedBlock, effectiveTip, err := flashbot.WaitForInclusion(
+		ctx,
+		client,
+		bundleHash,
+		targetBlocks[0],
+		targetBlocks[len(targetBlocks)-1],
+	)
+	ExitOnError(logger, err)
+
+	level.Info(logger).Log("msg", "Bundle included",
+		"block", includedBlock,
+		"effectiveTip", effectiveTip,
+	)
+
 	// Output:
 }
 
@@ -154,6 +157,18 @@ func ExitOnError(logger log.Logger, err error) {
 	}
 }
 
+// FirstBundleHash returns the bundle hash from the first relay that accepted the bundle,
+// since a relay submission can fail independently of the others.
+func FirstBundleHash(results []BundleRelayResult) (common.Hash, error) {
+	for _, result := range results {
+		if result.Err == nil && result.Resp != nil {
+			return common.HexToHash(result.Resp.BundleHash), nil
+		}
+	}
+
+	return common.Hash{}, errors.New("no relay accepted the bundle")
+}
+
 func GetKeys() (*common.Address, *ecdsa.PrivateKey, error) {
 	_privateKey := os.Getenv("ETH_PRIVATE_KEY")
 	privateKey, err := crypto.HexToECDSA(strings.TrimSpace(_privateKey))
@@ -179,19 +194,6 @@ func Keccak256(input []byte) [32]byte {
 	return hashed
 }
 
-func GetContractAddress(networkID *big.Int) (common.Address, error) {
-	switch netID := networkID.Int64(); netID {
-	case 1:
-		return common.HexToAddress(contractAddressMainnet), nil
-	case 4:
-		return common.HexToAddress(contractAddressRinkeby), nil
-	case 5:
-		return common.HexToAddress(contractAddressGoerli), nil
-	default:
-		return common.Address{}, errors.Errorf("network id not supported id:%v", netID)
-	}
-}
-
 const ContractABI = `[
 	{
 	   "inputs":[
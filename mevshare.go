@@ -0,0 +1,156 @@
+package flashbot
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// MevBundle is a MEV-Share v0.1 bundle, see
+// https://docs.flashbots.net/flashbots-mev-share/searchers/understanding-bundles.
+type MevBundle struct {
+	Version   string          `json:"version"`
+	Inclusion MevInclusion    `json:"inclusion"`
+	Body      []MevBundleItem `json:"body"`
+	Privacy   *MevPrivacy     `json:"privacy,omitempty"`
+}
+
+// MevInclusion bounds the blocks a MevBundle is allowed to land in.
+type MevInclusion struct {
+	Block    uint64
+	MaxBlock uint64
+}
+
+// MarshalJSON encodes the block numbers as hex strings, as the MEV-Share schema requires.
+func (i MevInclusion) MarshalJSON() ([]byte, error) {
+	wire := struct {
+		Block    string `json:"block"`
+		MaxBlock string `json:"maxBlock,omitempty"`
+	}{
+		Block: hexutil.EncodeUint64(i.Block),
+	}
+	if i.MaxBlock != 0 {
+		wire.MaxBlock = hexutil.EncodeUint64(i.MaxBlock)
+	}
+
+	return json.Marshal(wire)
+}
+
+// MevBundleItem is one entry of a MevBundle's body: either a reference to a pending tx hash
+// shared by the relay, a raw signed tx, or a nested bundle.
+type MevBundleItem struct {
+	Hash      *common.Hash `json:"hash,omitempty"`
+	Tx        string       `json:"tx,omitempty"`
+	Bundle    *MevBundle   `json:"bundle,omitempty"`
+	CanRevert bool         `json:"canRevert,omitempty"`
+}
+
+// MevPrivacy controls what the relay is allowed to reveal about a MevBundle.
+type MevPrivacy struct {
+	Hints    []string `json:"hints,omitempty"`
+	Builders []string `json:"builders,omitempty"`
+}
+
+// MevBundleResp is one relay's response to submitting a MevBundle.
+type MevBundleResp struct {
+	BundleHash string `json:"bundleHash"`
+}
+
+// MevBundleRelayResult is one relay's outcome of a MEV-Share bundle submission.
+type MevBundleRelayResult struct {
+	Relay string
+	Resp  *MevBundleResp
+	Err   error
+}
+
+// SendMevBundle submits a MEV-Share bundle via mev_sendBundle to every configured relay.
+func (f *Flashbot) SendMevBundle(bundle MevBundle) ([]MevBundleRelayResult, error) {
+	if len(f.relays) == 0 {
+		return nil, errors.New("no relay configured")
+	}
+
+	raws := f.doAll("mev_sendBundle", []interface{}{bundle})
+
+	out := make([]MevBundleRelayResult, len(raws))
+	for i, raw := range raws {
+		out[i] = MevBundleRelayResult{Relay: raw.Relay, Err: raw.Err}
+		if raw.Err != nil {
+			continue
+		}
+
+		var resp MevBundleResp
+		if err := json.Unmarshal(raw.Raw, &resp); err != nil {
+			out[i].Err = errors.Wrap(err, "unmarshaling mev bundle response")
+			continue
+		}
+		out[i].Resp = &resp
+	}
+
+	return out, nil
+}
+
+// MevShareHint is one pending-tx hint streamed over a relay's MEV-Share SSE feed: the parts
+// of a pending tx the relay shares with searchers so they can build a backrun. Fields the
+// relay did not share are left empty.
+type MevShareHint struct {
+	Hash             common.Hash       `json:"hash"`
+	Logs             []json.RawMessage `json:"logs"`
+	Txs              []json.RawMessage `json:"txs"`
+	FunctionSelector string            `json:"functionSelector"`
+	CallData         string            `json:"callData"`
+}
+
+// SubscribeMevShareHints streams pending-tx hints until ctx is cancelled or the stream ends,
+// closing the returned channel when it returns. It sends a GET to the first configured
+// relay's URL and reads the response as an SSE stream of "data:" lines; lines that do not
+// decode as a MevShareHint are skipped.
+func (f *Flashbot) SubscribeMevShareHints(ctx context.Context) (<-chan MevShareHint, error) {
+	if len(f.relays) == 0 {
+		return nil, errors.New("no relay configured")
+	}
+	relay := f.relays[0]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, relay.URL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request")
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "subscribing to relay:%v", relay.URL)
+	}
+
+	hints := make(chan MevShareHint)
+	go func() {
+		defer close(hints)
+		defer resp.Body.Close()
+
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			data := strings.TrimPrefix(scanner.Text(), "data:")
+			if data == scanner.Text() {
+				continue // Not an SSE data line.
+			}
+
+			var hint MevShareHint
+			if err := json.Unmarshal([]byte(strings.TrimSpace(data)), &hint); err != nil {
+				continue
+			}
+
+			select {
+			case hints <- hint:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return hints, nil
+}
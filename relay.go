@@ -0,0 +1,151 @@
+package flashbot
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// defaultAuthHeader is the header Flashbots, and most compatible relays, expect the
+// searcher's request signature in.
+const defaultAuthHeader = "X-Flashbots-Signature"
+
+// Relay is one MEV relay a Flashbot client submits to.
+type Relay struct {
+	// URL is the relay's JSON-RPC endpoint, e.g. https://relay.flashbots.net.
+	// SubscribeMevShareHints reads this same URL as an SSE event stream.
+	URL string
+	// SigningKey authenticates requests to this relay. It is the searcher's reputation key
+	// and does not need to hold any funds.
+	SigningKey *ecdsa.PrivateKey
+	// AuthHeader is the header the request signature is sent in. Defaults to
+	// X-Flashbots-Signature when empty, which bloXroute, Eden and most other relays also
+	// accept.
+	AuthHeader string
+}
+
+func (r Relay) authHeader() string {
+	if r.AuthHeader == "" {
+		return defaultAuthHeader
+	}
+	return r.AuthHeader
+}
+
+type jsonRPCRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      int           `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type jsonRPCResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *jsonRPCError   `json:"error"`
+}
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *jsonRPCError) Error() string {
+	return fmt.Sprintf("relay error code:%v msg:%v", e.Code, e.Message)
+}
+
+// RelayResult is one relay's raw outcome of a fanned-out submission.
+type RelayResult struct {
+	Relay string
+	Raw   json.RawMessage
+	Err   error
+}
+
+// doAll sends method/params to every configured relay concurrently. It never returns an
+// error itself, per-relay failures are reported in each RelayResult.Err so callers don't
+// lose the responses from relays that did succeed.
+func (f *Flashbot) doAll(method string, params []interface{}) []RelayResult {
+	results := make([]RelayResult, len(f.relays))
+
+	var wg sync.WaitGroup
+	for i, relay := range f.relays {
+		wg.Add(1)
+		go func(i int, relay Relay) {
+			defer wg.Done()
+			raw, err := doRelay(f.client, relay, method, params)
+			results[i] = RelayResult{Relay: relay.URL, Raw: raw, Err: err}
+		}(i, relay)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// do sends method/params to the first configured relay, for calls such as stats lookups
+// that are answered by one specific relay rather than fanned out to all of them.
+func (f *Flashbot) do(method string, params []interface{}, result interface{}) error {
+	if len(f.relays) == 0 {
+		return errors.New("no relay configured")
+	}
+
+	raw, err := doRelay(f.client, f.relays[0], method, params)
+	if err != nil {
+		return err
+	}
+
+	if result == nil {
+		return nil
+	}
+	return errors.Wrapf(json.Unmarshal(raw, result), "unmarshaling result method:%v", method)
+}
+
+// doRelay sends a single JSON-RPC request to relay, authenticating it with relay.SigningKey
+// as required by
+// https://docs.flashbots.net/flashbots-auction/searchers/advanced/rpc-endpoint#authentication.
+func doRelay(client *http.Client, relay Relay, method string, params []interface{}) (json.RawMessage, error) {
+	body, err := json.Marshal(jsonRPCRequest{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshaling request")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, relay.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	sig, err := crypto.Sign(crypto.Keccak256(body), relay.SigningKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "signing request body")
+	}
+	req.Header.Set(relay.authHeader(), fmt.Sprintf("%v:%v",
+		crypto.PubkeyToAddress(relay.SigningKey.PublicKey).Hex(),
+		hexutil.Encode(sig),
+	))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "calling relay:%v method:%v", relay.URL, method)
+	}
+	defer resp.Body.Close()
+
+	var rpcResp jsonRPCResponse
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return nil, errors.Wrapf(err, "decoding relay:%v response method:%v", relay.URL, method)
+	}
+	if rpcResp.Error != nil {
+		return nil, errors.Wrapf(rpcResp.Error, "relay:%v method:%v", relay.URL, method)
+	}
+
+	return rpcResp.Result, nil
+}
@@ -0,0 +1,181 @@
+package flashbot
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+)
+
+// waitForInclusionPollInterval is how often WaitForInclusion re-checks a block that wasn't
+// there yet.
+const waitForInclusionPollInterval = 3 * time.Second
+
+// BuilderTimestamp records when a builder reported considering or sealing a bundle.
+type BuilderTimestamp struct {
+	PubKey    string `json:"pubkey"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// BundleStats is the flashbots_getBundleStatsV2 response, describing how a relay saw a
+// previously submitted bundle.
+type BundleStats struct {
+	IsHighPriority         bool               `json:"isHighPriority"`
+	IsSentToMiners         bool               `json:"isSentToMiners"`
+	IsSimulated            bool               `json:"isSimulated"`
+	SimulatedAt            string             `json:"simulatedAt"`
+	SubmittedAt            string             `json:"submittedAt"`
+	SentToMinersAt         string             `json:"sentToMinersAt"`
+	ConsideredByBuildersAt []BuilderTimestamp `json:"consideredByBuildersAt"`
+	SealedByBuildersAt     []BuilderTimestamp `json:"sealedByBuildersAt"`
+}
+
+// GetBundleStats fetches the relay's record of what happened to a bundle previously
+// submitted for blockNumber via SendBundle.
+func (f *Flashbot) GetBundleStats(bundleHash common.Hash, blockNumber uint64) (*BundleStats, error) {
+	var stats BundleStats
+	if err := f.do("flashbots_getBundleStatsV2", []interface{}{
+		map[string]interface{}{
+			"bundleHash":  bundleHash.Hex(),
+			"blockNumber": hexutil.EncodeUint64(blockNumber),
+		},
+	}, &stats); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// UserStats is the flashbots_getUserStatsV2 response, describing the searcher's standing
+// with the relay.
+type UserStats struct {
+	IsHighPriority       bool   `json:"is_high_priority"`
+	AllTimeMinerPayments string `json:"all_time_miner_payments"`
+	AllTimeGasSimulated  string `json:"all_time_gas_simulated"`
+	Last7dMinerPayments  string `json:"last_7d_miner_payments"`
+	Last7dGasSimulated   string `json:"last_7d_gas_simulated"`
+	Last1dMinerPayments  string `json:"last_1d_miner_payments"`
+	Last1dGasSimulated   string `json:"last_1d_gas_simulated"`
+}
+
+// GetUserStats fetches the relay's reputation stats for the searcher key used by f, as of
+// blockNumber.
+func (f *Flashbot) GetUserStats(blockNumber uint64) (*UserStats, error) {
+	var stats UserStats
+	if err := f.do("flashbots_getUserStatsV2", []interface{}{
+		map[string]interface{}{
+			"blockNumber": hexutil.EncodeUint64(blockNumber),
+		},
+	}, &stats); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// sentBundleMaxAge bounds how many blocks a remembered bundle is kept around for, so a
+// client sending many bundles per block doesn't leak memory for bundles nobody ever calls
+// WaitForInclusion on.
+const sentBundleMaxAge = 256
+
+// sentBundle remembers the tx hashes behind a bundleHash returned by SendBundle, so
+// WaitForInclusion can later look for them in canonical blocks.
+type sentBundle struct {
+	txHashes    []common.Hash
+	blockNumber uint64
+}
+
+// rememberSentBundle records the tx hashes behind bundleHash, targeted at blockNumber, on
+// this client and evicts any previously remembered bundle older than sentBundleMaxAge
+// blocks.
+func (f *Flashbot) rememberSentBundle(bundleHash common.Hash, txs []string, blockNumber uint64) error {
+	hashes := make([]common.Hash, 0, len(txs))
+	for _, txHex := range txs {
+		raw, err := hexutil.Decode(txHex)
+		if err != nil {
+			return errors.Wrap(err, "decoding tx hex")
+		}
+		var tx types.Transaction
+		if err := tx.UnmarshalBinary(raw); err != nil {
+			return errors.Wrap(err, "unmarshaling tx")
+		}
+		hashes = append(hashes, tx.Hash())
+	}
+
+	f.sentBundlesMu.Lock()
+	defer f.sentBundlesMu.Unlock()
+
+	for hash, sent := range f.sentBundles {
+		if blockNumber > sent.blockNumber+sentBundleMaxAge {
+			delete(f.sentBundles, hash)
+		}
+	}
+	f.sentBundles[bundleHash] = sentBundle{txHashes: hashes, blockNumber: blockNumber}
+
+	return nil
+}
+
+// WaitForInclusion polls client for any of bundleHash's transactions appearing in a
+// canonical block between fromBlock and toBlock, blocking until it finds one, ctx is
+// cancelled, or toBlock is passed without a match. It returns the block the bundle landed in
+// and the effective tip the included tx paid on top of the base fee. bundleHash must have
+// been returned by a prior call to SendBundle on this client. The remembered bundle is
+// forgotten once this call resolves, whether or not it was found.
+func (f *Flashbot) WaitForInclusion(ctx context.Context, client *ethclient.Client, bundleHash common.Hash, fromBlock, toBlock uint64) (includedBlock uint64, effectiveTip *big.Int, err error) {
+	f.sentBundlesMu.Lock()
+	sent, ok := f.sentBundles[bundleHash]
+	f.sentBundlesMu.Unlock()
+	if !ok {
+		return 0, nil, errors.Errorf("bundle hash:%v was not sent via SendBundle on this client", bundleHash)
+	}
+	defer func() {
+		f.sentBundlesMu.Lock()
+		delete(f.sentBundles, bundleHash)
+		f.sentBundlesMu.Unlock()
+	}()
+	txHashes := sent.txHashes
+
+	ticker := time.NewTicker(waitForInclusionPollInterval)
+	defer ticker.Stop()
+
+	for blockNum := fromBlock; blockNum <= toBlock; {
+		block, err := client.BlockByNumber(ctx, new(big.Int).SetUint64(blockNum))
+		if errors.Is(err, ethereum.NotFound) {
+			// blockNum isn't mined yet, wait for the next tick and retry the same block.
+			select {
+			case <-ctx.Done():
+				return 0, nil, ctx.Err()
+			case <-ticker.C:
+			}
+			continue
+		}
+		if err != nil {
+			return 0, nil, errors.Wrapf(err, "getting block:%v", blockNum)
+		}
+
+		for _, tx := range block.Transactions() {
+			if containsHash(txHashes, tx.Hash()) {
+				return blockNum, tx.EffectiveGasTipValue(block.BaseFee()), nil
+			}
+		}
+
+		blockNum++
+	}
+
+	return 0, nil, errors.Errorf("bundle hash:%v not included between blocks %v..%v", bundleHash, fromBlock, toBlock)
+}
+
+func containsHash(hashes []common.Hash, h common.Hash) bool {
+	for _, candidate := range hashes {
+		if candidate == h {
+			return true
+		}
+	}
+	return false
+}